@@ -67,6 +67,7 @@ func buildCmd() *cobra.Command {
 		output               string
 		watch                bool
 		multiValueReturnMode string
+		outputMode           string
 	)
 
 	cmd := &cobra.Command{
@@ -86,19 +87,40 @@ Example:
   dingo build --multi-value-return=single file.dingo  # Restrict to (T, error) only`,
 		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runBuild(args, output, watch, multiValueReturnMode)
+			mode, err := parseOutputMode(outputMode)
+			if err != nil {
+				return err
+			}
+			return runBuild(args, output, watch, multiValueReturnMode, mode)
 		},
 	}
 
 	cmd.Flags().StringVarP(&output, "output", "o", "", "Output file path (default: replace .dingo with .go)")
 	cmd.Flags().BoolVarP(&watch, "watch", "w", false, "Watch for file changes and rebuild")
 	cmd.Flags().StringVar(&multiValueReturnMode, "multi-value-return", "full", "Multi-value return propagation mode: 'full' (default, supports (A,B,error)) or 'single' (restricts to (T,error))")
+	cmd.Flags().StringVar(&outputMode, "output-format", "auto", "Progress output format: 'pretty', 'plain', 'json', or 'auto' (detect from terminal)")
 
 	return cmd
 }
 
+// parseOutputMode validates a --output-format flag value and converts it to
+// a ui.OutputMode.
+func parseOutputMode(value string) (ui.OutputMode, error) {
+	switch ui.OutputMode(value) {
+	case "", ui.OutputAuto:
+		return ui.OutputAuto, nil
+	case ui.OutputPretty, ui.OutputPlain, ui.OutputJSON:
+		return ui.OutputMode(value), nil
+	default:
+		return "", fmt.Errorf("invalid --output-format: %q (must be 'pretty', 'plain', 'json', or 'auto')", value)
+	}
+}
+
 func runCmd() *cobra.Command {
-	var multiValueReturnMode string
+	var (
+		multiValueReturnMode string
+		outputMode           string
+	)
 
 	cmd := &cobra.Command{
 		Use:   "run [file.dingo] [-- args...]",
@@ -127,11 +149,17 @@ Examples:
 				programArgs = args[1:]
 			}
 
-			return runDingoFile(inputFile, programArgs, multiValueReturnMode)
+			mode, err := parseOutputMode(outputMode)
+			if err != nil {
+				return err
+			}
+
+			return runDingoFile(inputFile, programArgs, multiValueReturnMode, mode)
 		},
 	}
 
 	cmd.Flags().StringVar(&multiValueReturnMode, "multi-value-return", "full", "Multi-value return propagation mode: 'full' (default, supports (A,B,error)) or 'single' (restricts to (T,error))")
+	cmd.Flags().StringVar(&outputMode, "output-format", "auto", "Progress output format: 'pretty', 'plain', 'json', or 'auto' (detect from terminal)")
 
 	return cmd
 }
@@ -146,7 +174,7 @@ func versionCmd() *cobra.Command {
 	}
 }
 
-func runBuild(files []string, output string, watch bool, multiValueReturnMode string) error {
+func runBuild(files []string, output string, watch bool, multiValueReturnMode string, outputMode ui.OutputMode) error {
 	// Create config from flags
 	config := &preprocessor.Config{
 		MultiValueReturnMode: multiValueReturnMode,
@@ -158,7 +186,7 @@ func runBuild(files []string, output string, watch bool, multiValueReturnMode st
 	}
 
 	// Create beautiful output handler
-	buildUI := ui.NewBuildOutput()
+	buildUI := ui.NewBuildOutput(outputMode)
 
 	// Print header
 	buildUI.PrintHeader(version)
@@ -328,9 +356,9 @@ func buildFile(inputPath string, outputPath string, buildUI *ui.BuildOutput, con
 	return nil
 }
 
-func runDingoFile(inputPath string, programArgs []string, multiValueReturnMode string) error {
+func runDingoFile(inputPath string, programArgs []string, multiValueReturnMode string, outputMode ui.OutputMode) error {
 	// Create beautiful output
-	buildUI := ui.NewBuildOutput()
+	buildUI := ui.NewBuildOutput(outputMode)
 
 	// Print minimal header for run mode
 	buildUI.PrintHeader(version)
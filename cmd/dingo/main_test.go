@@ -0,0 +1,51 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/MadAppGang/dingo/pkg/ui"
+)
+
+func TestParseOutputMode(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		want      ui.OutputMode
+		wantError bool
+		errorMsg  string
+	}{
+		{name: "empty defaults to auto", value: "", want: ui.OutputAuto},
+		{name: "auto", value: "auto", want: ui.OutputAuto},
+		{name: "pretty", value: "pretty", want: ui.OutputPretty},
+		{name: "plain", value: "plain", want: ui.OutputPlain},
+		{name: "json", value: "json", want: ui.OutputJSON},
+		{
+			name:      "invalid value",
+			value:     "fancy",
+			wantError: true,
+			errorMsg:  `invalid --output-format: "fancy"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseOutputMode(tt.value)
+			if tt.wantError {
+				if err == nil {
+					t.Fatalf("parseOutputMode(%q) = nil error, want error containing %q", tt.value, tt.errorMsg)
+				}
+				if !strings.Contains(err.Error(), tt.errorMsg) {
+					t.Errorf("parseOutputMode(%q) error = %q, want it to contain %q", tt.value, err.Error(), tt.errorMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseOutputMode(%q) returned unexpected error: %v", tt.value, err)
+			}
+			if got != tt.want {
+				t.Errorf("parseOutputMode(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
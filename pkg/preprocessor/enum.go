@@ -21,11 +21,21 @@ var (
 
 	// Matches tuple variant: Variant(type1, type2, ...)
 	tupleVariantPattern = regexp.MustCompile(`^\s*(\w+)\s*\(([^)]*)\)\s*,?\s*$`)
+
+	// Matches the body of an @[json_tag="kind"] attribute, e.g. json_tag="kind"
+	jsonTagAttrPattern = regexp.MustCompile(`^json_tag\s*=\s*"([^"]*)"$`)
 )
 
+// defaultJSONTagKey is the discriminator field name used by the generated
+// MarshalJSON/UnmarshalJSON methods when an enum has no @[json_tag="..."]
+// attribute.
+const defaultJSONTagKey = "type"
+
 // EnumProcessor transforms enum declarations into Go sum types
 type EnumProcessor struct {
-	mappings []Mapping
+	mappings  []Mapping
+	needsFmt  bool // set when the generated String()/JSON methods call fmt.Sprintf/Errorf
+	needsJSON bool // set when the generated Marshal/UnmarshalJSON methods call encoding/json
 }
 
 // NewEnumProcessor creates a new enum preprocessor
@@ -46,10 +56,28 @@ func (e *EnumProcessor) Process(source []byte) ([]byte, []Mapping, error) {
 	return []byte(result), nil, err
 }
 
+// GetNeededImports implements the ImportProvider interface.
+// It reports "fmt" when a processed enum has at least one field (the
+// generated String() method calls fmt.Sprintf) or any enum was processed at
+// all (the generated JSON methods call fmt.Errorf), and "encoding/json" when
+// any enum's MarshalJSON/UnmarshalJSON methods were generated.
+func (e *EnumProcessor) GetNeededImports() []string {
+	var imports []string
+	if e.needsFmt {
+		imports = append(imports, "fmt")
+	}
+	if e.needsJSON {
+		imports = append(imports, "encoding/json")
+	}
+	return imports
+}
+
 // ProcessInternal transforms enum declarations to Go sum types with metadata emission
 func (e *EnumProcessor) ProcessInternal(code string) (string, []TransformMetadata, error) {
 	var metadata []TransformMetadata
 	counter := 0
+	e.needsFmt = false
+	e.needsJSON = false
 
 	// Find all enum declarations using manual parsing (handles nested braces)
 	enums := e.findEnumDeclarations([]byte(code))
@@ -71,7 +99,7 @@ func (e *EnumProcessor) ProcessInternal(code string) (string, []TransformMetadat
 		}
 
 		// Generate Go sum type with marker
-		generated := e.generateSumTypeWithMarker(enum.name, variants, &counter)
+		generated := e.generateSumTypeWithMarker(enum.name, variants, enum.typeParams, enum.jsonTagKey, &counter)
 
 		// Replace enum declaration with generated code
 		result = append(result[:enum.start], append([]byte(generated), result[enum.end:]...)...)
@@ -95,10 +123,12 @@ func (e *EnumProcessor) ProcessInternal(code string) (string, []TransformMetadat
 
 // enumDecl represents a parsed enum declaration
 type enumDecl struct {
-	start int
-	end   int
-	name  string
-	body  string
+	start      int
+	end        int
+	name       string
+	body       string
+	typeParams []string // generic type parameters, e.g. ["T"] for enum Option<T>
+	jsonTagKey string   // discriminator field for MarshalJSON, from @[json_tag="..."] (defaultJSONTagKey if absent)
 }
 
 // findEnumDeclarations finds all enum declarations with proper brace matching
@@ -145,6 +175,14 @@ func (e *EnumProcessor) findEnumDeclarations(source []byte) []enumDecl {
 
 		enumName := src[nameStart:nameEnd]
 
+		// Parse optional generic type parameters: enum Option<T> or enum Result<T, E>
+		typeParams := e.parseTypeParams(src, nameEnd)
+
+		// Parse an optional @[json_tag="kind"] attribute immediately preceding
+		// this enum, consuming it along with the declaration so it doesn't
+		// leak into the generated Go output.
+		declStart, jsonTagKey := e.parseJSONTagAttribute(src, idx)
+
 		// Find opening brace
 		braceStart := nameEnd
 		for braceStart < len(src) && src[braceStart] != '{' {
@@ -176,10 +214,12 @@ func (e *EnumProcessor) findEnumDeclarations(source []byte) []enumDecl {
 		}
 
 		decls = append(decls, enumDecl{
-			start: idx,
-			end:   enumEnd,
-			name:  enumName,
-			body:  body,
+			start:      declStart,
+			end:        enumEnd,
+			name:       enumName,
+			body:       body,
+			typeParams: typeParams,
+			jsonTagKey: jsonTagKey,
 		})
 
 		pos = braceEnd + 1
@@ -188,6 +228,66 @@ func (e *EnumProcessor) findEnumDeclarations(source []byte) []enumDecl {
 	return decls
 }
 
+// parseTypeParams looks ahead from the end of the enum name for a `<...>`
+// type parameter list and returns the parameter names (e.g. ["T", "E"]).
+// Returns nil if the enum has no type parameters.
+func (e *EnumProcessor) parseTypeParams(src string, nameEnd int) []string {
+	scanPos := nameEnd
+	for scanPos < len(src) && src[scanPos] != '{' && src[scanPos] != '<' {
+		scanPos++
+	}
+	if scanPos >= len(src) || src[scanPos] != '<' {
+		return nil
+	}
+
+	closeIdx := strings.IndexByte(src[scanPos:], '>')
+	if closeIdx == -1 {
+		return nil
+	}
+	closeIdx += scanPos
+
+	var typeParams []string
+	for _, p := range strings.Split(src[scanPos+1:closeIdx], ",") {
+		p = strings.TrimSpace(p)
+		if p != "" {
+			typeParams = append(typeParams, p)
+		}
+	}
+
+	return typeParams
+}
+
+// parseJSONTagAttribute looks immediately before an "enum" keyword at enumIdx
+// for a preceding "@[json_tag=\"kind\"]" attribute line (only whitespace may
+// separate the two). It returns the declaration's true start offset (the
+// attribute's start if present, otherwise enumIdx) and the discriminator
+// field name to use for JSON marshaling (defaultJSONTagKey if absent or
+// malformed).
+func (e *EnumProcessor) parseJSONTagAttribute(src string, enumIdx int) (declStart int, jsonTagKey string) {
+	pos := enumIdx
+	for pos > 0 && (src[pos-1] == ' ' || src[pos-1] == '\t' || src[pos-1] == '\n' || src[pos-1] == '\r') {
+		pos--
+	}
+	if pos == 0 || src[pos-1] != ']' {
+		return enumIdx, defaultJSONTagKey
+	}
+
+	closeBracket := pos - 1
+	openBracket := strings.LastIndexByte(src[:closeBracket], '[')
+	if openBracket == -1 || openBracket == 0 || src[openBracket-1] != '@' {
+		return enumIdx, defaultJSONTagKey
+	}
+	atIdx := openBracket - 1
+
+	attrBody := strings.TrimSpace(src[openBracket+1 : closeBracket])
+	match := jsonTagAttrPattern.FindStringSubmatch(attrBody)
+	if match == nil {
+		return enumIdx, defaultJSONTagKey
+	}
+
+	return atIdx, match[1]
+}
+
 // findMatchingBrace finds the closing brace that matches the opening brace at pos
 func (e *EnumProcessor) findMatchingBrace(src string, openPos int) int {
 	if openPos >= len(src) || src[openPos] != '{' {
@@ -372,9 +472,69 @@ func (e *EnumProcessor) parseTupleFields(typesStr string) ([]Field, error) {
 	return fields, nil
 }
 
+// formatTypeParams renders an enum's type parameters for two contexts:
+// decl is used where the parameters are introduced (struct and func
+// declarations, e.g. "[T any, E any]"); ref is used where the enum type is
+// merely referenced (receivers, return types, e.g. "[T, E]"). Both are empty
+// strings for non-generic enums.
+func formatTypeParams(typeParams []string) (decl string, ref string) {
+	if len(typeParams) == 0 {
+		return "", ""
+	}
+
+	declParts := make([]string, len(typeParams))
+	for i, p := range typeParams {
+		declParts[i] = p + " any"
+	}
+
+	return "[" + strings.Join(declParts, ", ") + "]", "[" + strings.Join(typeParams, ", ") + "]"
+}
+
+// variantFieldNames returns the generated struct field name for each of a
+// variant's fields, in declaration order, using the same single-tuple /
+// multi-tuple / struct-field naming scheme used when the struct itself is
+// generated.
+func variantFieldNames(variant Variant) []string {
+	isSingleTupleVariant := len(variant.Fields) == 1 &&
+		len(variant.Fields[0].Name) > 0 &&
+		variant.Fields[0].Name[0] >= '0' &&
+		variant.Fields[0].Name[0] <= '9'
+
+	names := make([]string, len(variant.Fields))
+	for fieldIdx, field := range variant.Fields {
+		isTupleField := len(field.Name) > 0 && field.Name[0] >= '0' && field.Name[0] <= '9'
+
+		switch {
+		case isSingleTupleVariant:
+			names[fieldIdx] = strings.ToLower(variant.Name)
+		case isTupleField:
+			baseName := strings.ToLower(variant.Name)
+			if fieldIdx == 0 {
+				names[fieldIdx] = baseName
+			} else {
+				names[fieldIdx] = fmt.Sprintf("%s%d", baseName, fieldIdx)
+			}
+		default:
+			names[fieldIdx] = strings.ToLower(variant.Name) + "_" + field.Name
+		}
+	}
+
+	return names
+}
+
+// isTupleVariant reports whether a variant's fields were declared
+// positionally (e.g. Triple(int, string, bool)) rather than by name.
+func isTupleVariant(variant Variant) bool {
+	return len(variant.Fields) > 0 &&
+		len(variant.Fields[0].Name) > 0 &&
+		variant.Fields[0].Name[0] >= '0' &&
+		variant.Fields[0].Name[0] <= '9'
+}
+
 // generateSumType generates Go sum type code from enum definition
-func (e *EnumProcessor) generateSumType(enumName string, variants []Variant) string {
+func (e *EnumProcessor) generateSumType(enumName string, variants []Variant, typeParams []string, jsonTagKey string) string {
 	var buf bytes.Buffer
+	typeDecl, typeRef := formatTypeParams(typeParams)
 
 	// 1. Generate tag type
 	tagTypeName := fmt.Sprintf("%sTag", enumName)
@@ -393,7 +553,7 @@ func (e *EnumProcessor) generateSumType(enumName string, variants []Variant) str
 	buf.WriteString(")\n\n")
 
 	// 3. Generate struct with tag and fields
-	buf.WriteString(fmt.Sprintf("type %s struct {\n", enumName))
+	buf.WriteString(fmt.Sprintf("type %s%s struct {\n", enumName, typeDecl))
 	buf.WriteString("\ttag " + tagTypeName + "\n")
 
 	// CRITICAL BUG FIX: Collect all fields from ALL variants into struct
@@ -409,40 +569,10 @@ func (e *EnumProcessor) generateSumType(enumName string, variants []Variant) str
 	fieldMap := make(map[string]string) // fieldName -> fieldType (for deduplication)
 
 	for _, variant := range variants {
-		if len(variant.Fields) > 0 {
-			// Determine field naming strategy for this variant
-			isSingleTupleVariant := len(variant.Fields) == 1 &&
-				len(variant.Fields[0].Name) > 0 &&
-				variant.Fields[0].Name[0] >= '0' &&
-				variant.Fields[0].Name[0] <= '9'
-
-			for fieldIdx, field := range variant.Fields {
-				var fieldName string
-				isTupleField := len(field.Name) > 0 && field.Name[0] >= '0' && field.Name[0] <= '9'
-
-				if isSingleTupleVariant {
-					// Single tuple field - use variant name (e.g., "ok", "err", "some")
-					fieldName = strings.ToLower(variant.Name)
-				} else if isTupleField {
-					// Multiple tuple fields - use proper naming convention
-					// First field: lowercase variant name (no suffix)
-					// Second field: lowercase variant name + "1"
-					// Third field: lowercase variant name + "2"
-					// etc.
-					baseName := strings.ToLower(variant.Name)
-					if fieldIdx == 0 {
-						fieldName = baseName // First field: no suffix
-					} else {
-						fieldName = fmt.Sprintf("%s%d", baseName, fieldIdx) // 2nd+ fields: suffix with index (1, 2, 3...)
-					}
-				} else {
-					// Struct variant with named fields - use variant_fieldname
-					fieldName = strings.ToLower(variant.Name) + "_" + field.Name
-				}
-
-				// Add to field map (deduplicates if same field used in multiple variants)
-				fieldMap[fieldName] = field.Type
-			}
+		fieldNames := variantFieldNames(variant)
+		for fieldIdx, field := range variant.Fields {
+			// Add to field map (deduplicates if same field used in multiple variants)
+			fieldMap[fieldNames[fieldIdx]] = field.Type
 		}
 	}
 
@@ -463,24 +593,19 @@ func (e *EnumProcessor) generateSumType(enumName string, variants []Variant) str
 
 	// 4. Generate constructor functions
 	for _, variant := range variants {
-		constructorName := fmt.Sprintf("%s%s", enumName, variant.Name)
+		constructorName := fmt.Sprintf("%s_%s", enumName, variant.Name)
 		tagConstName := fmt.Sprintf("%s%s", tagTypeName, variant.Name)
 
 		if len(variant.Fields) == 0 {
 			// Unit variant constructor
-			buf.WriteString(fmt.Sprintf("func %s() %s {\n", constructorName, enumName))
-			buf.WriteString(fmt.Sprintf("\treturn %s{tag: %s}\n", enumName, tagConstName))
+			buf.WriteString(fmt.Sprintf("func %s%s() %s%s {\n", constructorName, typeDecl, enumName, typeRef))
+			buf.WriteString(fmt.Sprintf("\treturn %s%s{tag: %s}\n", enumName, typeRef, tagConstName))
 			buf.WriteString("}\n")
 		} else {
 			// Struct variant constructor
 			params := []string{}
 			assignments := []string{}
-
-			// CRITICAL: Use same field naming strategy as struct generation above
-			isSingleTupleVariant := len(variant.Fields) == 1 &&
-				len(variant.Fields[0].Name) > 0 &&
-				variant.Fields[0].Name[0] >= '0' &&
-				variant.Fields[0].Name[0] <= '9'
+			fieldNames := variantFieldNames(variant)
 
 			for fieldIdx, field := range variant.Fields {
 				// Determine parameter name
@@ -491,32 +616,14 @@ func (e *EnumProcessor) generateSumType(enumName string, variants []Variant) str
 					paramName = "arg" + field.Name
 				}
 
-				// Determine field name using SAME logic as struct generation
-				var fieldName string
-				if isSingleTupleVariant {
-					// Single tuple field - use variant name (e.g., "ok", "err", "some")
-					fieldName = strings.ToLower(variant.Name)
-				} else if isTupleField {
-					// Multiple tuple fields - use proper naming convention (first, second1, third2)
-					baseName := strings.ToLower(variant.Name)
-					if fieldIdx == 0 {
-						fieldName = baseName // First field: no suffix
-					} else {
-						fieldName = fmt.Sprintf("%s%d", baseName, fieldIdx) // 2nd+ fields: suffix 1, 2, 3
-					}
-				} else {
-					// Struct variant with named fields - use variant_fieldname
-					fieldName = strings.ToLower(variant.Name) + "_" + field.Name
-				}
-
 				params = append(params, fmt.Sprintf("%s %s", paramName, field.Type))
-				assignments = append(assignments, fmt.Sprintf("%s: &%s", fieldName, paramName))
+				assignments = append(assignments, fmt.Sprintf("%s: &%s", fieldNames[fieldIdx], paramName))
 			}
 
-			buf.WriteString(fmt.Sprintf("func %s(%s) %s {\n",
-				constructorName, strings.Join(params, ", "), enumName))
-			buf.WriteString(fmt.Sprintf("\treturn %s{tag: %s, %s}\n",
-				enumName, tagConstName, strings.Join(assignments, ", ")))
+			buf.WriteString(fmt.Sprintf("func %s%s(%s) %s%s {\n",
+				constructorName, typeDecl, strings.Join(params, ", "), enumName, typeRef))
+			buf.WriteString(fmt.Sprintf("\treturn %s%s{tag: %s, %s}\n",
+				enumName, typeRef, tagConstName, strings.Join(assignments, ", ")))
 			buf.WriteString("}\n")
 		}
 	}
@@ -524,21 +631,166 @@ func (e *EnumProcessor) generateSumType(enumName string, variants []Variant) str
 	// 5. Generate Is* methods
 	for _, variant := range variants {
 		tagConstName := fmt.Sprintf("%s%s", tagTypeName, variant.Name)
-		buf.WriteString(fmt.Sprintf("func (e %s) Is%s() bool {\n", enumName, variant.Name))
+		buf.WriteString(fmt.Sprintf("func (e %s%s) Is%s() bool {\n", enumName, typeRef, variant.Name))
 		buf.WriteString(fmt.Sprintf("\treturn e.tag == %s\n", tagConstName))
 		buf.WriteString("}\n")
 	}
 
 	// 6. Generate Map and AndThen methods for Option/Result-like enums
-	e.generateHelperMethods(&buf, enumName, tagTypeName, variants)
+	e.generateHelperMethods(&buf, enumName, tagTypeName, variants, typeRef)
+
+	// 7. Generate Match/MatchR dispatch helpers, Variants(), and String()
+	e.generateMatchMethod(&buf, enumName, tagTypeName, variants, typeRef)
+	e.generateMatchRFunc(&buf, enumName, tagTypeName, variants, typeParams, typeRef)
+	e.generateVariantsFunc(&buf, enumName, tagTypeName, variants)
+	e.generateStringMethod(&buf, enumName, tagTypeName, variants, typeRef)
+
+	// 8. Generate JSON/Text marshaling for use as wire types
+	e.generateMarshalMethods(&buf, enumName, tagTypeName, variants, typeRef, jsonTagKey)
 
 	return buf.String()
 }
 
+// matchCallbackParams renders the parameter list for a variant's Match/MatchR
+// callback, e.g. "radius float64" or "width float64, height float64". Tuple
+// fields (unnamed in source) get synthetic arg0, arg1, ... names.
+func matchCallbackParams(variant Variant) string {
+	params := make([]string, len(variant.Fields))
+	for i, field := range variant.Fields {
+		name := field.Name
+		if isTupleVariant(variant) {
+			name = fmt.Sprintf("arg%d", i)
+		}
+		params[i] = fmt.Sprintf("%s %s", name, field.Type)
+	}
+	return strings.Join(params, ", ")
+}
+
+// matchCallbackArgs renders the dereferenced struct fields passed to a
+// variant's Match/MatchR callback, e.g. "*e.circle_radius".
+func matchCallbackArgs(receiver string, fieldNames []string) string {
+	args := make([]string, len(fieldNames))
+	for i, name := range fieldNames {
+		args[i] = fmt.Sprintf("*%s.%s", receiver, name)
+	}
+	return strings.Join(args, ", ")
+}
+
+// generateMatchMethod emits a Match method that dispatches to one callback
+// per variant, giving callers compile-checked exhaustive handling: adding a
+// variant breaks every call site until it supplies the new callback.
+func (e *EnumProcessor) generateMatchMethod(buf *bytes.Buffer, enumName, tagTypeName string, variants []Variant, typeRef string) {
+	params := make([]string, len(variants))
+	for i, variant := range variants {
+		params[i] = fmt.Sprintf("on%s func(%s)", variant.Name, matchCallbackParams(variant))
+	}
+
+	buf.WriteString("\n")
+	buf.WriteString(fmt.Sprintf("func (e %s%s) Match(%s) {\n", enumName, typeRef, strings.Join(params, ", ")))
+	buf.WriteString("\tswitch e.tag {\n")
+	for _, variant := range variants {
+		tagConstName := fmt.Sprintf("%s%s", tagTypeName, variant.Name)
+		buf.WriteString(fmt.Sprintf("\tcase %s:\n", tagConstName))
+		buf.WriteString(fmt.Sprintf("\t\ton%s(%s)\n", variant.Name, matchCallbackArgs("e", variantFieldNames(variant))))
+	}
+	buf.WriteString("\tdefault:\n")
+	buf.WriteString(fmt.Sprintf("\t\tpanic(\"unknown %s variant\")\n", enumName))
+	buf.WriteString("\t}\n")
+	buf.WriteString("}\n")
+}
+
+// generateMatchRFunc emits a typed MatchR variant of Match that returns a
+// value. Go does not allow a method to introduce type parameters beyond its
+// receiver's, so MatchR is generated as a standalone function taking the
+// enum value as its first argument rather than as a method.
+func (e *EnumProcessor) generateMatchRFunc(buf *bytes.Buffer, enumName, tagTypeName string, variants []Variant, typeParams []string, typeRef string) {
+	funcTypeParams := append(append([]string{}, typeParams...), "R")
+	funcTypeDecl, _ := formatTypeParams(funcTypeParams)
+
+	params := make([]string, 0, len(variants)+1)
+	params = append(params, fmt.Sprintf("e %s%s", enumName, typeRef))
+	for _, variant := range variants {
+		callbackParams := matchCallbackParams(variant)
+		if callbackParams == "" {
+			params = append(params, fmt.Sprintf("on%s func() R", variant.Name))
+		} else {
+			params = append(params, fmt.Sprintf("on%s func(%s) R", variant.Name, callbackParams))
+		}
+	}
+
+	buf.WriteString("\n")
+	buf.WriteString(fmt.Sprintf("func %s_MatchR%s(%s) R {\n", enumName, funcTypeDecl, strings.Join(params, ", ")))
+	buf.WriteString("\tswitch e.tag {\n")
+	for _, variant := range variants {
+		tagConstName := fmt.Sprintf("%s%s", tagTypeName, variant.Name)
+		buf.WriteString(fmt.Sprintf("\tcase %s:\n", tagConstName))
+		buf.WriteString(fmt.Sprintf("\t\treturn on%s(%s)\n", variant.Name, matchCallbackArgs("e", variantFieldNames(variant))))
+	}
+	buf.WriteString("\t}\n")
+	buf.WriteString(fmt.Sprintf("\tpanic(\"unknown %s variant\")\n", enumName))
+	buf.WriteString("}\n")
+}
+
+// generateVariantsFunc emits a function listing every tag value, so callers
+// (and tests) can enumerate variants without hand-maintaining the list.
+func (e *EnumProcessor) generateVariantsFunc(buf *bytes.Buffer, enumName, tagTypeName string, variants []Variant) {
+	tagConstNames := make([]string, len(variants))
+	for i, variant := range variants {
+		tagConstNames[i] = fmt.Sprintf("%s%s", tagTypeName, variant.Name)
+	}
+
+	buf.WriteString("\n")
+	buf.WriteString(fmt.Sprintf("func %s_Variants() []%s {\n", enumName, tagTypeName))
+	buf.WriteString(fmt.Sprintf("\treturn []%s{%s}\n", tagTypeName, strings.Join(tagConstNames, ", ")))
+	buf.WriteString("}\n")
+}
+
+// generateStringMethod emits a String() method implementing fmt.Stringer,
+// rendering variants as "Enum::Variant{field: value}" (struct-style),
+// "Enum::Variant(value)" (tuple-style), or bare "Enum::Variant" (unit).
+func (e *EnumProcessor) generateStringMethod(buf *bytes.Buffer, enumName, tagTypeName string, variants []Variant, typeRef string) {
+	buf.WriteString("\n")
+	buf.WriteString(fmt.Sprintf("func (e %s%s) String() string {\n", enumName, typeRef))
+	buf.WriteString("\tswitch e.tag {\n")
+	for _, variant := range variants {
+		tagConstName := fmt.Sprintf("%s%s", tagTypeName, variant.Name)
+		buf.WriteString(fmt.Sprintf("\tcase %s:\n", tagConstName))
+
+		if len(variant.Fields) == 0 {
+			buf.WriteString(fmt.Sprintf("\t\treturn %q\n", fmt.Sprintf("%s::%s", enumName, variant.Name)))
+			continue
+		}
+
+		fieldNames := variantFieldNames(variant)
+		valueArgs := make([]string, len(fieldNames))
+		for i, name := range fieldNames {
+			valueArgs[i] = fmt.Sprintf("*e.%s", name)
+		}
+
+		e.needsFmt = true
+		if isTupleVariant(variant) {
+			placeholders := strings.Repeat("%v, ", len(variant.Fields))
+			placeholders = strings.TrimSuffix(placeholders, ", ")
+			format := fmt.Sprintf("%s::%s(%s)", enumName, variant.Name, placeholders)
+			buf.WriteString(fmt.Sprintf("\t\treturn fmt.Sprintf(%q, %s)\n", format, strings.Join(valueArgs, ", ")))
+		} else {
+			parts := make([]string, len(variant.Fields))
+			for i, field := range variant.Fields {
+				parts[i] = fmt.Sprintf("%s: %%v", field.Name)
+			}
+			format := fmt.Sprintf("%s::%s{%s}", enumName, variant.Name, strings.Join(parts, ", "))
+			buf.WriteString(fmt.Sprintf("\t\treturn fmt.Sprintf(%q, %s)\n", format, strings.Join(valueArgs, ", ")))
+		}
+	}
+	buf.WriteString("\t}\n")
+	buf.WriteString(fmt.Sprintf("\treturn %q\n", fmt.Sprintf("%s::<unknown>", enumName)))
+	buf.WriteString("}\n")
+}
+
 // generateSumTypeWithMarker generates Go sum type code with marker support
-func (e *EnumProcessor) generateSumTypeWithMarker(enumName string, variants []Variant, markerCounter *int) string {
+func (e *EnumProcessor) generateSumTypeWithMarker(enumName string, variants []Variant, typeParams []string, jsonTagKey string, markerCounter *int) string {
 	// Generate the sum type using existing method
-	generated := e.generateSumType(enumName, variants)
+	generated := e.generateSumType(enumName, variants, typeParams, jsonTagKey)
 
 	// Insert marker
 	marker := fmt.Sprintf("// dingo:n:%d\n", *markerCounter)
@@ -550,7 +802,7 @@ func (e *EnumProcessor) generateSumTypeWithMarker(enumName string, variants []Va
 
 // generateHelperMethods generates Map and AndThen methods for Option/Result-like enums
 // These methods enable functional chaining patterns
-func (e *EnumProcessor) generateHelperMethods(buf *bytes.Buffer, enumName, tagTypeName string, variants []Variant) {
+func (e *EnumProcessor) generateHelperMethods(buf *bytes.Buffer, enumName, tagTypeName string, variants []Variant, typeRef string) {
 	// Detect if this is an Option or Result type based on variant names
 	isOption := e.hasVariants(variants, []string{"Some", "None"})
 	isResult := e.hasVariants(variants, []string{"Ok", "Err"})
@@ -561,11 +813,11 @@ func (e *EnumProcessor) generateHelperMethods(buf *bytes.Buffer, enumName, tagTy
 	}
 
 	if isOption {
-		e.generateOptionHelpers(buf, enumName, tagTypeName, variants)
+		e.generateOptionHelpers(buf, enumName, tagTypeName, variants, typeRef)
 	}
 
 	if isResult {
-		e.generateResultHelpers(buf, enumName, tagTypeName, variants)
+		e.generateResultHelpers(buf, enumName, tagTypeName, variants, typeRef)
 	}
 }
 
@@ -583,7 +835,7 @@ func (e *EnumProcessor) hasVariants(variants []Variant, names []string) bool {
 }
 
 // generateOptionHelpers generates Map and AndThen for Option types
-func (e *EnumProcessor) generateOptionHelpers(buf *bytes.Buffer, enumName, tagTypeName string, variants []Variant) {
+func (e *EnumProcessor) generateOptionHelpers(buf *bytes.Buffer, enumName, tagTypeName string, variants []Variant, typeRef string) {
 	// Find the Some variant to get the value type
 	var someVariant *Variant
 	for i := range variants {
@@ -598,16 +850,17 @@ func (e *EnumProcessor) generateOptionHelpers(buf *bytes.Buffer, enumName, tagTy
 	}
 
 	valueType := someVariant.Fields[0].Type
-	fieldName := "some" // lowercase variant name
+	fieldName := variantFieldNames(*someVariant)[0]
 
 	// Map(fn func(T) T) Option
-	// Note: Since Go lacks generics, we can only map T -> T, not T -> U
+	// Note: without an additional type parameter on the method itself, we can
+	// only map T -> T, not T -> U
 	buf.WriteString("\n")
-	buf.WriteString(fmt.Sprintf("func (o %s) Map(fn func(%s) %s) %s {\n", enumName, valueType, valueType, enumName))
+	buf.WriteString(fmt.Sprintf("func (o %s%s) Map(fn func(%s) %s) %s%s {\n", enumName, typeRef, valueType, valueType, enumName, typeRef))
 	buf.WriteString("\tswitch o.tag {\n")
 	buf.WriteString(fmt.Sprintf("\tcase %sSome:\n", tagTypeName))
 	buf.WriteString(fmt.Sprintf("\t\tif o.%s != nil {\n", fieldName))
-	buf.WriteString(fmt.Sprintf("\t\t\treturn %sSome(fn(*o.%s))\n", enumName, fieldName))
+	buf.WriteString(fmt.Sprintf("\t\t\treturn %s_Some%s(fn(*o.%s))\n", enumName, typeRef, fieldName))
 	buf.WriteString("\t\t}\n")
 	buf.WriteString(fmt.Sprintf("\tcase %sNone:\n", tagTypeName))
 	buf.WriteString("\t\treturn o\n")
@@ -617,7 +870,7 @@ func (e *EnumProcessor) generateOptionHelpers(buf *bytes.Buffer, enumName, tagTy
 
 	// AndThen(fn func(T) Option) Option
 	buf.WriteString("\n")
-	buf.WriteString(fmt.Sprintf("func (o %s) AndThen(fn func(%s) %s) %s {\n", enumName, valueType, enumName, enumName))
+	buf.WriteString(fmt.Sprintf("func (o %s%s) AndThen(fn func(%s) %s%s) %s%s {\n", enumName, typeRef, valueType, enumName, typeRef, enumName, typeRef))
 	buf.WriteString("\tswitch o.tag {\n")
 	buf.WriteString(fmt.Sprintf("\tcase %sSome:\n", tagTypeName))
 	buf.WriteString(fmt.Sprintf("\t\tif o.%s != nil {\n", fieldName))
@@ -631,7 +884,7 @@ func (e *EnumProcessor) generateOptionHelpers(buf *bytes.Buffer, enumName, tagTy
 }
 
 // generateResultHelpers generates Map and AndThen for Result types
-func (e *EnumProcessor) generateResultHelpers(buf *bytes.Buffer, enumName, tagTypeName string, variants []Variant) {
+func (e *EnumProcessor) generateResultHelpers(buf *bytes.Buffer, enumName, tagTypeName string, variants []Variant, typeRef string) {
 	// Find Ok and Err variants
 	var okVariant, errVariant *Variant
 	for i := range variants {
@@ -647,16 +900,19 @@ func (e *EnumProcessor) generateResultHelpers(buf *bytes.Buffer, enumName, tagTy
 	}
 
 	okType := okVariant.Fields[0].Type
-	okFieldName := "ok" // lowercase variant name
+	okFieldName := variantFieldNames(*okVariant)[0]
 
 	// Map(fn func(T) T) Result
-	// Note: Since Go lacks generics, we can only map T -> T, not T -> U
+	// Note: without an additional type parameter on the method itself, we can
+	// only map T -> T, not T -> U
 	buf.WriteString("\n")
-	buf.WriteString(fmt.Sprintf("func (r %s) Map(fn func(%s) %s) %s {\n", enumName, okType, okType, enumName))
+	buf.WriteString(fmt.Sprintf("func (r %s%s) Map(fn func(%s) %s) %s%s {\n", enumName, typeRef, okType, okType, enumName, typeRef))
 	buf.WriteString("\tswitch r.tag {\n")
 	buf.WriteString(fmt.Sprintf("\tcase %sOk:\n", tagTypeName))
 	buf.WriteString(fmt.Sprintf("\t\tif r.%s != nil {\n", okFieldName))
-	buf.WriteString(fmt.Sprintf("\t\t\treturn %sOk(fn(*r.%s))\n", enumName, okFieldName))
+	// Only the Ok field's type is inferable from fn's argument, so the Err
+	// type parameter (if any) must be supplied explicitly at the call site.
+	buf.WriteString(fmt.Sprintf("\t\t\treturn %s_Ok%s(fn(*r.%s))\n", enumName, typeRef, okFieldName))
 	buf.WriteString("\t\t}\n")
 	buf.WriteString(fmt.Sprintf("\tcase %sErr:\n", tagTypeName))
 	buf.WriteString("\t\treturn r\n")
@@ -666,7 +922,7 @@ func (e *EnumProcessor) generateResultHelpers(buf *bytes.Buffer, enumName, tagTy
 
 	// AndThen(fn func(T) Result) Result
 	buf.WriteString("\n")
-	buf.WriteString(fmt.Sprintf("func (r %s) AndThen(fn func(%s) %s) %s {\n", enumName, okType, enumName, enumName))
+	buf.WriteString(fmt.Sprintf("func (r %s%s) AndThen(fn func(%s) %s%s) %s%s {\n", enumName, typeRef, okType, enumName, typeRef, enumName, typeRef))
 	buf.WriteString("\tswitch r.tag {\n")
 	buf.WriteString(fmt.Sprintf("\tcase %sOk:\n", tagTypeName))
 	buf.WriteString(fmt.Sprintf("\t\tif r.%s != nil {\n", okFieldName))
@@ -678,3 +934,211 @@ func (e *EnumProcessor) generateResultHelpers(buf *bytes.Buffer, enumName, tagTy
 	buf.WriteString(fmt.Sprintf("\tpanic(\"invalid %s state\")\n", enumName))
 	buf.WriteString("}\n")
 }
+
+// jsonFieldIdentifier returns the exported Go field name and JSON key to use
+// for a variant field inside a generated marshal/unmarshal payload struct.
+// Tuple fields (unnamed in source, numeric placeholder names) become
+// Field0/"field0", Field1/"field1", ...; named fields are simply capitalized,
+// keeping the original name as the wire key.
+func jsonFieldIdentifier(field Field, idx int) (goName, jsonKey string) {
+	if len(field.Name) > 0 && field.Name[0] >= '0' && field.Name[0] <= '9' {
+		return fmt.Sprintf("Field%d", idx), fmt.Sprintf("field%d", idx)
+	}
+	return strings.ToUpper(field.Name[:1]) + field.Name[1:], field.Name
+}
+
+// generateMarshalMethods emits MarshalJSON/UnmarshalJSON and
+// MarshalText/UnmarshalText, turning the enum into a first-class wire type
+// for HTTP/RPC handlers. Unit-only enums (no variant carries fields) marshal
+// to a bare JSON string, e.g. "Pending"; enums with at least one variant
+// carrying fields use an internally-tagged representation, e.g.
+// {"type":"Circle","radius":1.5}, with jsonTagKey controlling the
+// discriminator field name. MarshalText/UnmarshalText mirror this: a unit-only
+// enum's text form is just the variant name, while an enum with fields has no
+// simpler textual representation than its JSON form, so it delegates to it.
+func (e *EnumProcessor) generateMarshalMethods(buf *bytes.Buffer, enumName, tagTypeName string, variants []Variant, typeRef, jsonTagKey string) {
+	allUnit := true
+	for _, variant := range variants {
+		if len(variant.Fields) > 0 {
+			allUnit = false
+			break
+		}
+	}
+
+	e.needsJSON = true
+	e.needsFmt = true
+
+	if allUnit {
+		e.generateUnitJSONMethods(buf, enumName, tagTypeName, variants, typeRef)
+		e.generateUnitTextMethods(buf, enumName, tagTypeName, variants, typeRef)
+		return
+	}
+
+	e.generateTaggedJSONMethods(buf, enumName, tagTypeName, variants, typeRef, jsonTagKey)
+	e.generateDelegatingTextMethods(buf, enumName, typeRef)
+}
+
+// generateUnitJSONMethods emits MarshalJSON/UnmarshalJSON for an enum whose
+// variants carry no fields, representing each variant as a bare JSON string.
+func (e *EnumProcessor) generateUnitJSONMethods(buf *bytes.Buffer, enumName, tagTypeName string, variants []Variant, typeRef string) {
+	buf.WriteString("\n")
+	buf.WriteString(fmt.Sprintf("func (e %s%s) MarshalJSON() ([]byte, error) {\n", enumName, typeRef))
+	buf.WriteString("\tswitch e.tag {\n")
+	for _, variant := range variants {
+		tagConstName := fmt.Sprintf("%s%s", tagTypeName, variant.Name)
+		buf.WriteString(fmt.Sprintf("\tcase %s:\n", tagConstName))
+		buf.WriteString(fmt.Sprintf("\t\treturn json.Marshal(%q)\n", variant.Name))
+	}
+	buf.WriteString("\t}\n")
+	buf.WriteString(fmt.Sprintf("\treturn nil, fmt.Errorf(\"%s: unknown variant\")\n", enumName))
+	buf.WriteString("}\n")
+
+	buf.WriteString("\n")
+	buf.WriteString(fmt.Sprintf("func (e *%s%s) UnmarshalJSON(data []byte) error {\n", enumName, typeRef))
+	buf.WriteString("\tvar name string\n")
+	buf.WriteString("\tif err := json.Unmarshal(data, &name); err != nil {\n")
+	buf.WriteString("\t\treturn err\n")
+	buf.WriteString("\t}\n")
+	buf.WriteString("\tswitch name {\n")
+	for _, variant := range variants {
+		buf.WriteString(fmt.Sprintf("\tcase %q:\n", variant.Name))
+		buf.WriteString(fmt.Sprintf("\t\t*e = %s_%s%s()\n", enumName, variant.Name, typeRef))
+		buf.WriteString("\t\treturn nil\n")
+	}
+	buf.WriteString("\tdefault:\n")
+	buf.WriteString(fmt.Sprintf("\t\treturn fmt.Errorf(\"%s: unknown variant %%q\", name)\n", enumName))
+	buf.WriteString("\t}\n")
+	buf.WriteString("}\n")
+}
+
+// generateUnitTextMethods emits MarshalText/UnmarshalText for an enum whose
+// variants carry no fields, representing each variant as its bare name.
+func (e *EnumProcessor) generateUnitTextMethods(buf *bytes.Buffer, enumName, tagTypeName string, variants []Variant, typeRef string) {
+	buf.WriteString("\n")
+	buf.WriteString(fmt.Sprintf("func (e %s%s) MarshalText() ([]byte, error) {\n", enumName, typeRef))
+	buf.WriteString("\tswitch e.tag {\n")
+	for _, variant := range variants {
+		tagConstName := fmt.Sprintf("%s%s", tagTypeName, variant.Name)
+		buf.WriteString(fmt.Sprintf("\tcase %s:\n", tagConstName))
+		buf.WriteString(fmt.Sprintf("\t\treturn []byte(%q), nil\n", variant.Name))
+	}
+	buf.WriteString("\t}\n")
+	buf.WriteString(fmt.Sprintf("\treturn nil, fmt.Errorf(\"%s: unknown variant\")\n", enumName))
+	buf.WriteString("}\n")
+
+	buf.WriteString("\n")
+	buf.WriteString(fmt.Sprintf("func (e *%s%s) UnmarshalText(text []byte) error {\n", enumName, typeRef))
+	buf.WriteString("\tswitch string(text) {\n")
+	for _, variant := range variants {
+		buf.WriteString(fmt.Sprintf("\tcase %q:\n", variant.Name))
+		buf.WriteString(fmt.Sprintf("\t\t*e = %s_%s%s()\n", enumName, variant.Name, typeRef))
+		buf.WriteString("\t\treturn nil\n")
+	}
+	buf.WriteString("\tdefault:\n")
+	buf.WriteString(fmt.Sprintf("\t\treturn fmt.Errorf(\"%s: unknown variant %%q\", string(text))\n", enumName))
+	buf.WriteString("\t}\n")
+	buf.WriteString("}\n")
+}
+
+// generateTaggedJSONMethods emits MarshalJSON/UnmarshalJSON for an enum with
+// at least one variant carrying fields, using an internally-tagged
+// representation: {"<jsonTagKey>":"Circle","radius":1.5}. UnmarshalJSON
+// rejects unknown variant names and validates that every required field for
+// the chosen variant was present before populating the enum's *T fields.
+func (e *EnumProcessor) generateTaggedJSONMethods(buf *bytes.Buffer, enumName, tagTypeName string, variants []Variant, typeRef, jsonTagKey string) {
+	buf.WriteString("\n")
+	buf.WriteString(fmt.Sprintf("func (e %s%s) MarshalJSON() ([]byte, error) {\n", enumName, typeRef))
+	buf.WriteString("\tswitch e.tag {\n")
+	for _, variant := range variants {
+		tagConstName := fmt.Sprintf("%s%s", tagTypeName, variant.Name)
+		buf.WriteString(fmt.Sprintf("\tcase %s:\n", tagConstName))
+
+		if len(variant.Fields) == 0 {
+			buf.WriteString("\t\treturn json.Marshal(struct {\n")
+			buf.WriteString(fmt.Sprintf("\t\t\tTag string `json:%q`\n", jsonTagKey))
+			buf.WriteString("\t\t}{\n")
+			buf.WriteString(fmt.Sprintf("\t\t\tTag: %q,\n", variant.Name))
+			buf.WriteString("\t\t})\n")
+			continue
+		}
+
+		fieldNames := variantFieldNames(variant)
+		buf.WriteString("\t\treturn json.Marshal(struct {\n")
+		buf.WriteString(fmt.Sprintf("\t\t\tTag string `json:%q`\n", jsonTagKey))
+		for idx, field := range variant.Fields {
+			goName, jsonKey := jsonFieldIdentifier(field, idx)
+			buf.WriteString(fmt.Sprintf("\t\t\t%s %s `json:%q`\n", goName, field.Type, jsonKey))
+		}
+		buf.WriteString("\t\t}{\n")
+		buf.WriteString(fmt.Sprintf("\t\t\tTag: %q,\n", variant.Name))
+		for idx, field := range variant.Fields {
+			goName, _ := jsonFieldIdentifier(field, idx)
+			buf.WriteString(fmt.Sprintf("\t\t\t%s: *e.%s,\n", goName, fieldNames[idx]))
+		}
+		buf.WriteString("\t\t})\n")
+	}
+	buf.WriteString("\t}\n")
+	buf.WriteString(fmt.Sprintf("\treturn nil, fmt.Errorf(\"%s: unknown variant\")\n", enumName))
+	buf.WriteString("}\n")
+
+	buf.WriteString("\n")
+	buf.WriteString(fmt.Sprintf("func (e *%s%s) UnmarshalJSON(data []byte) error {\n", enumName, typeRef))
+	buf.WriteString("\tvar probe struct {\n")
+	buf.WriteString(fmt.Sprintf("\t\tTag string `json:%q`\n", jsonTagKey))
+	buf.WriteString("\t}\n")
+	buf.WriteString("\tif err := json.Unmarshal(data, &probe); err != nil {\n")
+	buf.WriteString("\t\treturn err\n")
+	buf.WriteString("\t}\n")
+	buf.WriteString("\tswitch probe.Tag {\n")
+	for _, variant := range variants {
+		buf.WriteString(fmt.Sprintf("\tcase %q:\n", variant.Name))
+
+		if len(variant.Fields) == 0 {
+			buf.WriteString(fmt.Sprintf("\t\t*e = %s_%s%s()\n", enumName, variant.Name, typeRef))
+			buf.WriteString("\t\treturn nil\n")
+			continue
+		}
+
+		buf.WriteString("\t\tvar payload struct {\n")
+		for idx, field := range variant.Fields {
+			goName, jsonKey := jsonFieldIdentifier(field, idx)
+			buf.WriteString(fmt.Sprintf("\t\t\t%s *%s `json:%q`\n", goName, field.Type, jsonKey))
+		}
+		buf.WriteString("\t\t}\n")
+		buf.WriteString("\t\tif err := json.Unmarshal(data, &payload); err != nil {\n")
+		buf.WriteString("\t\t\treturn err\n")
+		buf.WriteString("\t\t}\n")
+
+		args := make([]string, len(variant.Fields))
+		for idx, field := range variant.Fields {
+			goName, jsonKey := jsonFieldIdentifier(field, idx)
+			buf.WriteString(fmt.Sprintf("\t\tif payload.%s == nil {\n", goName))
+			buf.WriteString(fmt.Sprintf("\t\t\treturn fmt.Errorf(\"%s: missing required field %%q for variant %%q\", %q, %q)\n", enumName, jsonKey, variant.Name))
+			buf.WriteString("\t\t}\n")
+			args[idx] = fmt.Sprintf("*payload.%s", goName)
+		}
+
+		buf.WriteString(fmt.Sprintf("\t\t*e = %s_%s%s(%s)\n", enumName, variant.Name, typeRef, strings.Join(args, ", ")))
+		buf.WriteString("\t\treturn nil\n")
+	}
+	buf.WriteString("\tdefault:\n")
+	buf.WriteString(fmt.Sprintf("\t\treturn fmt.Errorf(\"%s: unknown variant %%q\", probe.Tag)\n", enumName))
+	buf.WriteString("\t}\n")
+	buf.WriteString("}\n")
+}
+
+// generateDelegatingTextMethods emits MarshalText/UnmarshalText for an enum
+// with at least one variant carrying fields. There's no simpler unambiguous
+// textual form for a tagged payload than its JSON encoding, so these just
+// delegate to MarshalJSON/UnmarshalJSON.
+func (e *EnumProcessor) generateDelegatingTextMethods(buf *bytes.Buffer, enumName, typeRef string) {
+	buf.WriteString("\n")
+	buf.WriteString(fmt.Sprintf("func (e %s%s) MarshalText() ([]byte, error) {\n", enumName, typeRef))
+	buf.WriteString("\treturn e.MarshalJSON()\n")
+	buf.WriteString("}\n")
+
+	buf.WriteString("\n")
+	buf.WriteString(fmt.Sprintf("func (e *%s%s) UnmarshalText(text []byte) error {\n", enumName, typeRef))
+	buf.WriteString("\treturn e.UnmarshalJSON(text)\n")
+	buf.WriteString("}\n")
+}
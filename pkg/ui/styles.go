@@ -2,11 +2,14 @@
 package ui
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-isatty"
 )
 
 // Color palette - carefully chosen for readability and aesthetics
@@ -106,32 +109,168 @@ var (
 			Foreground(colorNormal)
 )
 
+// Step represents a build step status
+type Step struct {
+	Name     string
+	Status   StepStatus
+	Duration time.Duration
+	Message  string // Optional message (for warnings, etc.)
+}
+
+// StepStatus represents the status of a build step
+type StepStatus int
+
+const (
+	StepSuccess StepStatus = iota
+	StepSkipped
+	StepWarning
+	StepError
+)
+
+// String renders a StepStatus as a short lowercase label, used by the JSON
+// renderer's step events.
+func (s StepStatus) String() string {
+	switch s {
+	case StepSuccess:
+		return "success"
+	case StepSkipped:
+		return "skipped"
+	case StepWarning:
+		return "warning"
+	case StepError:
+		return "error"
+	default:
+		return "unknown"
+	}
+}
+
+// Renderer abstracts how build events reach the user, so BuildOutput can
+// drive a styled terminal UI, plain ASCII logs, or machine-readable JSON
+// without knowing which is in use.
+type Renderer interface {
+	Header(version string)
+	BuildStart(fileCount int)
+	FileStart(inputPath, outputPath string)
+	Step(step Step)
+	Summary(success bool, errorMsg string, elapsed time.Duration)
+	Error(msg string)
+	Warning(msg string)
+	Info(msg string)
+}
+
+// OutputMode selects which Renderer NewBuildOutput constructs.
+type OutputMode string
+
+const (
+	// OutputAuto picks OutputPretty or OutputPlain based on the NO_COLOR env
+	// var and whether stdout is a terminal; it never auto-selects OutputJSON.
+	OutputAuto   OutputMode = "auto"
+	OutputPretty OutputMode = "pretty"
+	OutputPlain  OutputMode = "plain"
+	OutputJSON   OutputMode = "json"
+)
+
 // BuildOutput manages the build output display
 type BuildOutput struct {
-	startTime time.Time
-	fileCount int
+	startTime   time.Time
+	fileCount   int
 	currentFile string
+	renderer    Renderer
 }
 
-// NewBuildOutput creates a new build output manager
-func NewBuildOutput() *BuildOutput {
+// NewBuildOutput creates a new build output manager using the renderer
+// selected by mode. OutputAuto (and any other unrecognized value, including
+// "") resolves to OutputPretty on an interactive, colored terminal and
+// OutputPlain otherwise.
+func NewBuildOutput(mode OutputMode) *BuildOutput {
 	return &BuildOutput{
 		startTime: time.Now(),
+		renderer:  newRenderer(mode),
 	}
 }
 
+// newRenderer resolves mode to a concrete Renderer.
+func newRenderer(mode OutputMode) Renderer {
+	switch mode {
+	case OutputJSON:
+		return newJSONRenderer()
+	case OutputPlain:
+		return plainRenderer{}
+	case OutputPretty:
+		return prettyRenderer{}
+	default:
+		if !isInteractiveColorTerminal() {
+			return plainRenderer{}
+		}
+		return prettyRenderer{}
+	}
+}
+
+// isInteractiveColorTerminal reports whether stdout is a color-capable
+// terminal: NO_COLOR disables it unconditionally, and a non-TTY stdout
+// (piped into CI logs, an editor, or a file) disables it regardless of
+// NO_COLOR.
+func isInteractiveColorTerminal() bool {
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	return isatty.IsTerminal(os.Stdout.Fd())
+}
+
 // PrintHeader prints the main Dingo header
 func (b *BuildOutput) PrintHeader(version string) {
-	header := styleHeader.Render("🐕 Dingo Compiler")
-	versionBadge := styleVersion.Render("v" + version)
-
-	fmt.Println(header + " " + versionBadge)
+	b.renderer.Header(version)
 }
 
 // PrintBuildStart prints the build start message
 func (b *BuildOutput) PrintBuildStart(fileCount int) {
 	b.fileCount = fileCount
+	b.renderer.BuildStart(fileCount)
+}
 
+// PrintFileStart prints the file being processed
+func (b *BuildOutput) PrintFileStart(inputPath, outputPath string) {
+	b.currentFile = inputPath
+	b.renderer.FileStart(inputPath, outputPath)
+}
+
+// PrintStep prints a build step with status
+func (b *BuildOutput) PrintStep(step Step) {
+	b.renderer.Step(step)
+}
+
+// PrintSummary prints the final build summary
+func (b *BuildOutput) PrintSummary(success bool, errorMsg string) {
+	b.renderer.Summary(success, errorMsg, time.Since(b.startTime))
+}
+
+// PrintError prints an error message
+func (b *BuildOutput) PrintError(msg string) {
+	b.renderer.Error(msg)
+}
+
+// PrintWarning prints a warning message
+func (b *BuildOutput) PrintWarning(msg string) {
+	b.renderer.Warning(msg)
+}
+
+// PrintInfo prints an info message
+func (b *BuildOutput) PrintInfo(msg string) {
+	b.renderer.Info(msg)
+}
+
+// prettyRenderer is the original lipgloss-styled renderer: Unicode icons,
+// colors, and borders. It's the default on an interactive color terminal.
+type prettyRenderer struct{}
+
+func (prettyRenderer) Header(version string) {
+	header := styleHeader.Render("🐕 Dingo Compiler")
+	versionBadge := styleVersion.Render("v" + version)
+
+	fmt.Println(header + " " + versionBadge)
+}
+
+func (prettyRenderer) BuildStart(fileCount int) {
 	var msg string
 	if fileCount == 1 {
 		msg = "📦 Building 1 file"
@@ -143,10 +282,7 @@ func (b *BuildOutput) PrintBuildStart(fileCount int) {
 	fmt.Println()
 }
 
-// PrintFileStart prints the file being processed
-func (b *BuildOutput) PrintFileStart(inputPath, outputPath string) {
-	b.currentFile = inputPath
-
+func (prettyRenderer) FileStart(inputPath, outputPath string) {
 	input := styleFileInput.Render(inputPath)
 	arrow := styleMuted.Render("→")
 	output := styleFileOutput.Render(outputPath)
@@ -155,26 +291,7 @@ func (b *BuildOutput) PrintFileStart(inputPath, outputPath string) {
 	fmt.Println()
 }
 
-// Step represents a build step status
-type Step struct {
-	Name     string
-	Status   StepStatus
-	Duration time.Duration
-	Message  string // Optional message (for warnings, etc.)
-}
-
-// StepStatus represents the status of a build step
-type StepStatus int
-
-const (
-	StepSuccess StepStatus = iota
-	StepSkipped
-	StepWarning
-	StepError
-)
-
-// PrintStep prints a build step with status
-func (b *BuildOutput) PrintStep(step Step) {
+func (prettyRenderer) Step(step Step) {
 	var icon, status, statusStyle string
 
 	switch step.Status {
@@ -219,10 +336,7 @@ func (b *BuildOutput) PrintStep(step Step) {
 	}
 }
 
-// PrintSummary prints the final build summary
-func (b *BuildOutput) PrintSummary(success bool, errorMsg string) {
-	elapsed := time.Since(b.startTime)
-
+func (prettyRenderer) Summary(success bool, errorMsg string, elapsed time.Duration) {
 	fmt.Println() // Extra line before summary
 
 	var summaryLine string
@@ -253,24 +367,159 @@ func (b *BuildOutput) PrintSummary(success bool, errorMsg string) {
 	fmt.Println(styleSummary.Render(summaryLine))
 }
 
-// PrintError prints an error message
-func (b *BuildOutput) PrintError(msg string) {
+func (prettyRenderer) Error(msg string) {
 	errLine := styleError.Render("✗ Error: ") + msg
 	fmt.Println(styleIndent.Render(errLine))
 }
 
-// PrintWarning prints a warning message
-func (b *BuildOutput) PrintWarning(msg string) {
+func (prettyRenderer) Warning(msg string) {
 	warnLine := styleWarning.Render("⚠ Warning: ") + msg
 	fmt.Println(styleIndent.Render(warnLine))
 }
 
-// PrintInfo prints an info message
-func (b *BuildOutput) PrintInfo(msg string) {
+func (prettyRenderer) Info(msg string) {
 	infoLine := styleMuted.Render("ℹ " + msg)
 	fmt.Println(styleIndent.Render(infoLine))
 }
 
+// plainRenderer renders the same events as prettyRenderer but as
+// uncolored text without emoji, icons, or box-drawing characters, for CI
+// logs and other non-TTY consumers that would otherwise see mangled escape
+// codes. Durations under a millisecond still use formatDuration's "µs" unit.
+type plainRenderer struct{}
+
+func (plainRenderer) Header(version string) {
+	fmt.Printf("Dingo Compiler v%s\n", version)
+}
+
+func (plainRenderer) BuildStart(fileCount int) {
+	if fileCount == 1 {
+		fmt.Println("Building 1 file")
+	} else {
+		fmt.Printf("Building %d files\n", fileCount)
+	}
+	fmt.Println()
+}
+
+func (plainRenderer) FileStart(inputPath, outputPath string) {
+	fmt.Printf("  %s -> %s\n", inputPath, outputPath)
+	fmt.Println()
+}
+
+func (plainRenderer) Step(step Step) {
+	label := plainStepLabel(step.Status)
+	line := fmt.Sprintf("  [%s] %s", label, step.Name)
+	if step.Duration > 0 {
+		line += " (" + formatDuration(step.Duration) + ")"
+	}
+	fmt.Println(line)
+
+	if step.Message != "" {
+		fmt.Println("    " + step.Message)
+	}
+}
+
+func (plainRenderer) Summary(success bool, errorMsg string, elapsed time.Duration) {
+	fmt.Println()
+
+	if success {
+		fmt.Printf("Success! Built in %s\n", formatDuration(elapsed))
+		return
+	}
+
+	fmt.Println("Build failed")
+	if errorMsg != "" {
+		fmt.Printf("  Error: %s\n", errorMsg)
+	}
+}
+
+func (plainRenderer) Error(msg string) {
+	fmt.Printf("  Error: %s\n", msg)
+}
+
+func (plainRenderer) Warning(msg string) {
+	fmt.Printf("  Warning: %s\n", msg)
+}
+
+func (plainRenderer) Info(msg string) {
+	fmt.Printf("  Info: %s\n", msg)
+}
+
+// plainStepLabel renders a StepStatus as the short tag that plainRenderer's
+// step lines show in brackets, e.g. "OK" (shown as "[OK]") or "FAIL".
+func plainStepLabel(status StepStatus) string {
+	switch status {
+	case StepSuccess:
+		return "OK"
+	case StepSkipped:
+		return "SKIP"
+	case StepWarning:
+		return "WARN"
+	case StepError:
+		return "FAIL"
+	default:
+		return "?"
+	}
+}
+
+// jsonRenderer emits one JSON object per line to stdout, so LSP/fsnotify
+// watch loops and CI systems can consume build progress without scraping
+// styled text. Every event carries an "event" discriminator field.
+type jsonRenderer struct {
+	enc *json.Encoder
+}
+
+func newJSONRenderer() *jsonRenderer {
+	return &jsonRenderer{enc: json.NewEncoder(os.Stdout)}
+}
+
+func (r *jsonRenderer) emit(event map[string]any) {
+	_ = r.enc.Encode(event)
+}
+
+func (r *jsonRenderer) Header(version string) {
+	r.emit(map[string]any{"event": "header", "version": version})
+}
+
+func (r *jsonRenderer) BuildStart(fileCount int) {
+	r.emit(map[string]any{"event": "build_start", "file_count": fileCount})
+}
+
+func (r *jsonRenderer) FileStart(inputPath, outputPath string) {
+	r.emit(map[string]any{"event": "file_start", "input": inputPath, "output": outputPath})
+}
+
+func (r *jsonRenderer) Step(step Step) {
+	r.emit(map[string]any{
+		"event":       "step",
+		"name":        step.Name,
+		"status":      step.Status.String(),
+		"duration_ms": step.Duration.Milliseconds(),
+		"message":     step.Message,
+	})
+}
+
+func (r *jsonRenderer) Summary(success bool, errorMsg string, elapsed time.Duration) {
+	r.emit(map[string]any{
+		"event":       "summary",
+		"success":     success,
+		"duration_ms": elapsed.Milliseconds(),
+		"error":       errorMsg,
+	})
+}
+
+func (r *jsonRenderer) Error(msg string) {
+	r.emit(map[string]any{"event": "error", "message": msg})
+}
+
+func (r *jsonRenderer) Warning(msg string) {
+	r.emit(map[string]any{"event": "warning", "message": msg})
+}
+
+func (r *jsonRenderer) Info(msg string) {
+	r.emit(map[string]any{"event": "info", "message": msg})
+}
+
 // Helper functions
 
 // formatDuration formats a duration in a human-readable way
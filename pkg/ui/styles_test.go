@@ -0,0 +1,188 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestNewRenderer(t *testing.T) {
+	tests := []struct {
+		name string
+		mode OutputMode
+		want Renderer
+	}{
+		{name: "pretty", mode: OutputPretty, want: prettyRenderer{}},
+		{name: "plain", mode: OutputPlain, want: plainRenderer{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newRenderer(tt.mode)
+			if got != tt.want {
+				t.Errorf("newRenderer(%q) = %#v, want %#v", tt.mode, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("json", func(t *testing.T) {
+		got := newRenderer(OutputJSON)
+		if _, ok := got.(*jsonRenderer); !ok {
+			t.Errorf("newRenderer(%q) = %T, want *jsonRenderer", OutputJSON, got)
+		}
+	})
+}
+
+func TestNewRenderer_AutoRespectsNoColor(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+
+	got := newRenderer(OutputAuto)
+	if _, ok := got.(plainRenderer); !ok {
+		t.Errorf("newRenderer(OutputAuto) with NO_COLOR set = %T, want plainRenderer", got)
+	}
+}
+
+func TestNewRenderer_UnknownModeFallsBackToAuto(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+
+	got := newRenderer(OutputMode("bogus"))
+	if _, ok := got.(plainRenderer); !ok {
+		t.Errorf("newRenderer(%q) = %T, want plainRenderer (same as auto)", "bogus", got)
+	}
+}
+
+func TestIsInteractiveColorTerminal_NoColor(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+
+	if isInteractiveColorTerminal() {
+		t.Error("isInteractiveColorTerminal() = true with NO_COLOR set, want false")
+	}
+}
+
+func TestIsInteractiveColorTerminal_NonTTYStdout(t *testing.T) {
+	t.Setenv("NO_COLOR", "")
+
+	// go test captures stdout through a pipe, never a TTY, so this should
+	// reliably report false regardless of NO_COLOR in the test environment.
+	if isInteractiveColorTerminal() {
+		t.Error("isInteractiveColorTerminal() = true with non-TTY stdout, want false")
+	}
+}
+
+// captureStdout redirects os.Stdout for the duration of fn and returns
+// everything written to it.
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("failed to create pipe: %v", err)
+	}
+
+	original := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = original }()
+
+	fn()
+
+	if err := w.Close(); err != nil {
+		t.Fatalf("failed to close pipe writer: %v", err)
+	}
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("failed to read pipe: %v", err)
+	}
+	return string(out)
+}
+
+// decodeJSONLines decodes a stream of newline-delimited JSON objects, as
+// emitted one per call by jsonRenderer.
+func decodeJSONLines(t *testing.T, data string) []map[string]any {
+	t.Helper()
+
+	var events []map[string]any
+	dec := json.NewDecoder(bytes.NewReader([]byte(data)))
+	for {
+		var event map[string]any
+		if err := dec.Decode(&event); err != nil {
+			if err == io.EOF {
+				break
+			}
+			t.Fatalf("failed to decode JSON event: %v\nraw output:\n%s", err, data)
+		}
+		events = append(events, event)
+	}
+	return events
+}
+
+func TestJSONRenderer_Header(t *testing.T) {
+	out := captureStdout(t, func() { newJSONRenderer().Header("1.2.3") })
+
+	events := decodeJSONLines(t, out)
+	if len(events) != 1 {
+		t.Fatalf("expected 1 event, got %d: %v", len(events), events)
+	}
+	if events[0]["event"] != "header" {
+		t.Errorf("event = %v, want \"header\"", events[0]["event"])
+	}
+	if events[0]["version"] != "1.2.3" {
+		t.Errorf("version = %v, want \"1.2.3\"", events[0]["version"])
+	}
+}
+
+func TestJSONRenderer_Step(t *testing.T) {
+	step := Step{
+		Name:     "Parse",
+		Status:   StepWarning,
+		Duration: 42 * time.Millisecond,
+		Message:  "unused import",
+	}
+
+	out := captureStdout(t, func() { newJSONRenderer().Step(step) })
+
+	events := decodeJSONLines(t, out)
+	if len(events) != 1 {
+		t.Fatalf("expected 1 event, got %d: %v", len(events), events)
+	}
+	event := events[0]
+	if event["event"] != "step" {
+		t.Errorf("event = %v, want \"step\"", event["event"])
+	}
+	if event["name"] != "Parse" {
+		t.Errorf("name = %v, want \"Parse\"", event["name"])
+	}
+	if event["status"] != "warning" {
+		t.Errorf("status = %v, want \"warning\"", event["status"])
+	}
+	if event["duration_ms"] != float64(42) {
+		t.Errorf("duration_ms = %v, want 42", event["duration_ms"])
+	}
+	if event["message"] != "unused import" {
+		t.Errorf("message = %v, want \"unused import\"", event["message"])
+	}
+}
+
+func TestJSONRenderer_Summary(t *testing.T) {
+	out := captureStdout(t, func() { newJSONRenderer().Summary(false, "boom", 100*time.Millisecond) })
+
+	events := decodeJSONLines(t, out)
+	if len(events) != 1 {
+		t.Fatalf("expected 1 event, got %d: %v", len(events), events)
+	}
+	event := events[0]
+	if event["event"] != "summary" {
+		t.Errorf("event = %v, want \"summary\"", event["event"])
+	}
+	if event["success"] != false {
+		t.Errorf("success = %v, want false", event["success"])
+	}
+	if event["error"] != "boom" {
+		t.Errorf("error = %v, want \"boom\"", event["error"])
+	}
+	if event["duration_ms"] != float64(100) {
+		t.Errorf("duration_ms = %v, want 100", event["duration_ms"])
+	}
+}